@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the v1alpha1 group AccessControlList resources of
+// the Kafka provider.
+// +kubebuilder:object:generate=true
+// +groupName=acl.kafka.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// AccessControlList type metadata.
+const (
+	Group   = "acl.kafka.crossplane.io"
+	Version = "v1alpha1"
+	Kind    = "AccessControlList"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects.
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AccessControlListKind is the kind of an AccessControlList.
+	AccessControlListKind = Kind
+
+	// AccessControlListGroupKind is the GroupKind of an AccessControlList.
+	AccessControlListGroupKind = schema.GroupKind{Group: Group, Kind: Kind}.String()
+
+	// AccessControlListGroupVersionKind is the GroupVersionKind of an AccessControlList.
+	AccessControlListGroupVersionKind = SchemeGroupVersion.WithKind(Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccessControlList{}, &AccessControlListList{})
+}
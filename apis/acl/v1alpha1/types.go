@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessControlListParameters are the configurable fields of an AccessControlList.
+type AccessControlListParameters struct {
+	// ResourceType is the type of resource the ACL applies to, e.g. Topic, Group, Cluster.
+	// +kubebuilder:validation:Enum=Unknown;Any;Topic;Group;Cluster;TransactionalID;DelegationToken
+	ResourceType string `json:"resourceType"`
+
+	// ResourceName is the name of the resource the ACL applies to.
+	ResourceName string `json:"resourceName"`
+
+	// ResourcePatternType determines whether ResourceName is matched literally or as a prefix.
+	// +kubebuilder:validation:Enum=Unknown;Any;Match;Literal;Prefixed
+	// +kubebuilder:default=Literal
+	ResourcePatternType string `json:"resourcePatternType,omitempty"`
+
+	// Principal this ACL applies to, e.g. "User:Alice".
+	Principal string `json:"principal"`
+
+	// Host this ACL applies to, or "*" for any host.
+	// +kubebuilder:default="*"
+	Host string `json:"host,omitempty"`
+
+	// Operation this ACL allows or denies, e.g. Read, Write, Describe.
+	// +kubebuilder:validation:Enum=Unknown;Any;All;Read;Write;Create;Delete;Alter;Describe;ClusterAction;DescribeConfigs;AlterConfigs;IdempotentWrite
+	Operation string `json:"operation"`
+
+	// PermissionType of this ACL, either Allow or Deny.
+	// +kubebuilder:validation:Enum=Unknown;Any;Deny;Allow
+	// +kubebuilder:default=Allow
+	PermissionType string `json:"permissionType,omitempty"`
+}
+
+// AccessControlListObservation are the observable fields of an AccessControlList.
+type AccessControlListObservation struct {
+	// ProviderAuthorizedOperations are the operations the broker reports as
+	// authorized for the credentials configured on this ACL's ProviderConfig
+	// against this ACL's resource, per KIP-430. Kafka's describe APIs only
+	// ever report what the connection making the request is authorized to
+	// do, never an arbitrary third-party principal, so this reflects the
+	// provider's own credentials rather than this ACL's Principal.
+	ProviderAuthorizedOperations []string `json:"providerAuthorizedOperations,omitempty"`
+
+	// ClusterAuthorizedOperations are the operations the broker reports as
+	// authorized for the credentials configured on this ACL's ProviderConfig
+	// against the cluster resource itself, per KIP-430.
+	ClusterAuthorizedOperations []string `json:"clusterAuthorizedOperations,omitempty"`
+}
+
+// An AccessControlListSpec defines the desired state of an AccessControlList.
+type AccessControlListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessControlListParameters `json:"forProvider"`
+}
+
+// An AccessControlListStatus represents the observed state of an AccessControlList.
+type AccessControlListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessControlListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// An AccessControlList represents a Kafka ACL.
+type AccessControlList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessControlListSpec   `json:"spec"`
+	Status AccessControlListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessControlListList contains a list of AccessControlList.
+type AccessControlListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessControlList `json:"items"`
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterDescriptionParameters are the configurable fields of a ClusterDescription.
+// A ClusterDescription has no configurable fields: it only observes the
+// cluster its ProviderConfig points at.
+type ClusterDescriptionParameters struct {
+}
+
+// A Broker describes the observed state of a single broker.
+type Broker struct {
+	NodeID int32   `json:"nodeID"`
+	Host   string  `json:"host"`
+	Port   int32   `json:"port"`
+	Rack   *string `json:"rack,omitempty"`
+}
+
+// ClusterDescriptionObservation are the observable fields of a ClusterDescription.
+type ClusterDescriptionObservation struct {
+	// ClusterID is the Kafka cluster's unique ID.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// ControllerID is the node ID of the cluster's current controller.
+	ControllerID int32 `json:"controllerID,omitempty"`
+
+	// Brokers reflects every broker known to the cluster.
+	Brokers []Broker `json:"brokers,omitempty"`
+
+	// AuthorizedOperations are the operations the broker reports as
+	// authorized for the requesting principal against the cluster, per
+	// KIP-430.
+	AuthorizedOperations []string `json:"authorizedOperations,omitempty"`
+}
+
+// A ClusterDescriptionSpec defines the desired state of a ClusterDescription.
+type ClusterDescriptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterDescriptionParameters `json:"forProvider"`
+}
+
+// A ClusterDescriptionStatus represents the observed state of a ClusterDescription.
+type ClusterDescriptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterDescriptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// A ClusterDescription lets users import the observed state of the Kafka
+// cluster a ProviderConfig points at into Crossplane without managing it.
+type ClusterDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterDescriptionSpec   `json:"spec"`
+	Status ClusterDescriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterDescriptionList contains a list of ClusterDescription.
+type ClusterDescriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterDescription `json:"items"`
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the v1alpha1 group TopicDescription resources of
+// the Kafka provider.
+// +kubebuilder:object:generate=true
+// +groupName=topicdescription.kafka.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// TopicDescription type metadata.
+const (
+	Group   = "topicdescription.kafka.crossplane.io"
+	Version = "v1alpha1"
+	Kind    = "TopicDescription"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects.
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// TopicDescriptionKind is the kind of a TopicDescription.
+	TopicDescriptionKind = Kind
+
+	// TopicDescriptionGroupKind is the GroupKind of a TopicDescription.
+	TopicDescriptionGroupKind = schema.GroupKind{Group: Group, Kind: Kind}.String()
+
+	// TopicDescriptionGroupVersionKind is the GroupVersionKind of a TopicDescription.
+	TopicDescriptionGroupVersionKind = SchemeGroupVersion.WithKind(Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TopicDescription{}, &TopicDescriptionList{})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TopicDescriptionParameters are the configurable fields of a TopicDescription.
+type TopicDescriptionParameters struct {
+	// TopicName is the name of the topic to describe.
+	// +immutable
+	TopicName string `json:"topicName"`
+}
+
+// A TopicPartition describes the observed state of a single partition.
+type TopicPartition struct {
+	ID       int32   `json:"id"`
+	Leader   int32   `json:"leader"`
+	Replicas []int32 `json:"replicas"`
+	ISR      []int32 `json:"isr"`
+}
+
+// TopicDescriptionObservation are the observable fields of a TopicDescription.
+type TopicDescriptionObservation struct {
+	// Partitions reflects the leader, replicas and in-sync replicas of each
+	// partition of the topic.
+	Partitions []TopicPartition `json:"partitions,omitempty"`
+
+	// Configs reflects the topic-level configuration reported by the broker.
+	Configs map[string]string `json:"configs,omitempty"`
+
+	// AuthorizedOperations are the operations the broker reports as
+	// authorized for the requesting principal against this topic, per
+	// KIP-430.
+	AuthorizedOperations []string `json:"authorizedOperations,omitempty"`
+}
+
+// A TopicDescriptionSpec defines the desired state of a TopicDescription.
+type TopicDescriptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TopicDescriptionParameters `json:"forProvider"`
+}
+
+// A TopicDescriptionStatus represents the observed state of a TopicDescription.
+type TopicDescriptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TopicDescriptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// A TopicDescription lets users import an existing Kafka topic's observed
+// state into Crossplane without managing the topic's lifecycle.
+type TopicDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TopicDescriptionSpec   `json:"spec"`
+	Status TopicDescriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TopicDescriptionList contains a list of TopicDescription.
+type TopicDescriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TopicDescription `json:"items"`
+}
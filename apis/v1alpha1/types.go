@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// An AuthMechanism selects how the provider authenticates to its Kafka
+// cluster.
+type AuthMechanism string
+
+const (
+	// AuthMechanismPlain authenticates using SASL/PLAIN, or with no SASL
+	// layer at all if Credentials carries no username.
+	AuthMechanismPlain AuthMechanism = "Plain"
+
+	// AuthMechanismSCRAMSHA512 authenticates using SASL/SCRAM-SHA-512.
+	AuthMechanismSCRAMSHA512 AuthMechanism = "SCRAMSHA512"
+
+	// AuthMechanismOAuthBearer authenticates using SASL/OAUTHBEARER, fetching
+	// tokens via the OAuth2 client-credentials flow.
+	AuthMechanismOAuthBearer AuthMechanism = "OAuthBearer"
+
+	// AuthMechanismMTLS authenticates using mutual TLS.
+	AuthMechanismMTLS AuthMechanism = "MTLS"
+)
+
+// ProviderConfigSpec defines the desired state of a ProviderConfig.
+type ProviderConfigSpec struct {
+	// BrokerAddrs is a list of Kafka broker addresses, e.g. "localhost:9092".
+	BrokerAddrs []string `json:"brokerAddrs"`
+
+	// AuthMechanism this provider uses to authenticate to its Kafka cluster.
+	// +kubebuilder:validation:Enum=Plain;SCRAMSHA512;OAuthBearer;MTLS
+	// +kubebuilder:default=Plain
+	AuthMechanism AuthMechanism `json:"authMechanism,omitempty"`
+
+	// Credentials required to authenticate when AuthMechanism is Plain. Its
+	// Source is also the source the SCRAM, OAuthBearer and MTLS selectors
+	// are read from.
+	Credentials ProviderCredentials `json:"credentials"`
+
+	// SCRAM holds the credential selectors used when AuthMechanism is
+	// SCRAMSHA512.
+	SCRAM *SCRAMCredentials `json:"scram,omitempty"`
+
+	// OAuthBearer holds the credential selectors used when AuthMechanism is
+	// OAuthBearer.
+	OAuthBearer *OAuthBearerCredentials `json:"oauthBearer,omitempty"`
+
+	// MTLS holds the credential selectors used when AuthMechanism is MTLS.
+	MTLS *MTLSCredentials `json:"mtls,omitempty"`
+}
+
+// ProviderCredentials required to authenticate.
+type ProviderCredentials struct {
+	// Source of the provider credentials.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// SCRAMCredentials are the two independent Secret references that make up a
+// SASL/SCRAM-SHA-512 identity.
+type SCRAMCredentials struct {
+	// Username selects the Secret key holding the SCRAM username.
+	Username xpv1.CommonCredentialSelectors `json:"username"`
+
+	// Password selects the Secret key holding the SCRAM password.
+	Password xpv1.CommonCredentialSelectors `json:"password"`
+}
+
+// OAuthBearerCredentials configure the OAuth2 client-credentials flow used to
+// mint SASL/OAUTHBEARER tokens.
+type OAuthBearerCredentials struct {
+	// TokenURL is the OAuth2 token endpoint.
+	TokenURL string `json:"tokenURL"`
+
+	// ClientID selects the Secret key holding the OAuth2 client ID.
+	ClientID xpv1.CommonCredentialSelectors `json:"clientID"`
+
+	// ClientSecret selects the Secret key holding the OAuth2 client secret.
+	ClientSecret xpv1.CommonCredentialSelectors `json:"clientSecret"`
+}
+
+// MTLSCredentials are the cert/key/CA triple used to authenticate with
+// mutual TLS.
+type MTLSCredentials struct {
+	// Cert selects the Secret key holding the client certificate, PEM encoded.
+	Cert xpv1.CommonCredentialSelectors `json:"cert"`
+
+	// Key selects the Secret key holding the client private key, PEM encoded.
+	Key xpv1.CommonCredentialSelectors `json:"key"`
+
+	// CA selects the Secret key holding the CA bundle used to verify the
+	// broker's certificate, PEM encoded.
+	CA xpv1.CommonCredentialSelectors `json:"ca"`
+}
+
+// A ProviderConfigStatus reflects the observed state of a ProviderConfig.
+type ProviderConfigStatus struct {
+	xpv1.ProviderConfigStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// A ProviderConfig configures a Kafka provider.
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig.
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// A ProviderConfigUsage indicates that a resource is using a ProviderConfig.
+type ProviderConfigUsage struct {
+	metav1.TypeMeta          `json:",inline"`
+	metav1.ObjectMeta        `json:"metadata,omitempty"`
+	xpv1.ProviderConfigUsage `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigUsageList contains a list of ProviderConfigUsage.
+type ProviderConfigUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfigUsage `json:"items"`
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package acl translates AccessControlList managed resources into Kafka ACL
+// operations performed via kadm.
+package acl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kadm"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/acl/v1alpha1"
+)
+
+const (
+	errCreateACL               = "cannot create ACL"
+	errDeleteACL               = "cannot delete ACL"
+	errDescribeACL             = "cannot describe ACL"
+	errDescribeCluster         = "cannot describe cluster"
+	errDescribeTopic           = "cannot describe topic"
+	errUnsupportedResourceType = "unsupported ACL resource type"
+)
+
+// allOperations lists the KIP-430 AclOperation names in bit position order,
+// so that bit i of an authorized-operations bitmask corresponds to
+// allOperations[i].
+var allOperations = []string{
+	"Unknown",
+	"Any",
+	"All",
+	"Read",
+	"Write",
+	"Create",
+	"Delete",
+	"Alter",
+	"Describe",
+	"ClusterAction",
+	"DescribeConfigs",
+	"AlterConfigs",
+	"IdempotentWrite",
+}
+
+// OperationsFromBitmask translates a KIP-430 authorized-operations bitmask
+// into the stable operation names it contains.
+func OperationsFromBitmask(mask int32) []string {
+	var ops []string
+	for i, name := range allOperations {
+		if mask&(1<<uint(i)) != 0 {
+			ops = append(ops, name)
+		}
+	}
+	return ops
+}
+
+// A DescribedACL is a KafkaACL enriched with the operations the broker
+// reports as authorized for the requesting connection against its resource.
+// Per KIP-430, describe calls only ever report what the connection making
+// the request - this provider's own credentials - is authorized to do, never
+// an arbitrary third-party principal, so this does not necessarily reflect
+// what KafkaACL.Principal itself can do.
+type DescribedACL struct {
+	KafkaACL
+	ProviderAuthorizedOperations []string
+}
+
+// A KafkaACL is the set of fields that identify a single Kafka ACL entry.
+// Kafka ACLs are immutable: changing any of these fields produces a
+// different ACL rather than updating the existing one in place.
+type KafkaACL struct {
+	ResourceType        string `json:"resourceType"`
+	ResourceName        string `json:"resourceName"`
+	ResourcePatternType string `json:"resourcePatternType"`
+	Principal           string `json:"principal"`
+	Host                string `json:"host"`
+	Operation           string `json:"operation"`
+	PermissionType      string `json:"permissionType"`
+}
+
+// Generate builds the KafkaACL that corresponds to the given parameters.
+func Generate(p *v1alpha1.AccessControlListParameters) *KafkaACL {
+	return &KafkaACL{
+		ResourceType:        p.ResourceType,
+		ResourceName:        p.ResourceName,
+		ResourcePatternType: p.ResourcePatternType,
+		Principal:           p.Principal,
+		Host:                p.Host,
+		Operation:           p.Operation,
+		PermissionType:      p.PermissionType,
+	}
+}
+
+// CompareAcls returns true if the two KafkaACLs are identical.
+func CompareAcls(a, b KafkaACL) bool {
+	return len(Diff(a, b)) == 0
+}
+
+// Diff returns a list of human readable descriptions of the fields on which
+// a and b differ.
+func Diff(a, b KafkaACL) []string {
+	var diff []string
+	if a.ResourceType != b.ResourceType {
+		diff = append(diff, fmt.Sprintf("resourceType: %q != %q", a.ResourceType, b.ResourceType))
+	}
+	if a.ResourceName != b.ResourceName {
+		diff = append(diff, fmt.Sprintf("resourceName: %q != %q", a.ResourceName, b.ResourceName))
+	}
+	if a.ResourcePatternType != b.ResourcePatternType {
+		diff = append(diff, fmt.Sprintf("resourcePatternType: %q != %q", a.ResourcePatternType, b.ResourcePatternType))
+	}
+	if a.Principal != b.Principal {
+		diff = append(diff, fmt.Sprintf("principal: %q != %q", a.Principal, b.Principal))
+	}
+	if a.Host != b.Host {
+		diff = append(diff, fmt.Sprintf("host: %q != %q", a.Host, b.Host))
+	}
+	if a.Operation != b.Operation {
+		diff = append(diff, fmt.Sprintf("operation: %q != %q", a.Operation, b.Operation))
+	}
+	if a.PermissionType != b.PermissionType {
+		diff = append(diff, fmt.Sprintf("permissionType: %q != %q", a.PermissionType, b.PermissionType))
+	}
+	return diff
+}
+
+// ConvertToJSON serializes a KafkaACL so it can be stored as an external name.
+func ConvertToJSON(a *KafkaACL) (string, error) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ConvertFromJSON deserializes a KafkaACL previously stored as an external name.
+func ConvertFromJSON(s string) (*KafkaACL, error) {
+	a := &KafkaACL{}
+	if err := json.Unmarshal([]byte(s), a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// builder translates a into the equivalent kadm.ACLBuilder. It returns an
+// error for any ResourceType kadm has no dedicated builder method for,
+// rather than silently falling back to a Topic ACL.
+func builder(a *KafkaACL) (*kadm.ACLBuilder, error) {
+	b := kadm.NewACLs()
+	switch a.PermissionType {
+	case "Deny":
+		b = b.Deny(a.Principal)
+	default:
+		b = b.Allow(a.Principal)
+	}
+	b = b.ResourcePatternType(kadm.ACLPatternType(a.ResourcePatternType)).
+		Operations(kadm.ACLOperation(a.Operation)).
+		Hosts(a.Host)
+
+	switch a.ResourceType {
+	case "Topic", "":
+		b = b.Topics(a.ResourceName)
+	case "Group":
+		b = b.Groups(a.ResourceName)
+	case "Cluster":
+		b = b.Clusters()
+	case "TransactionalID":
+		b = b.TransactionalIDs(a.ResourceName)
+	default:
+		return nil, errors.Errorf("%s: %q", errUnsupportedResourceType, a.ResourceType)
+	}
+	return b, nil
+}
+
+// Create issues a CreateACLs request for the given KafkaACL.
+func Create(ctx context.Context, cl *kadm.Client, a *KafkaACL) error {
+	b, err := builder(a)
+	if err != nil {
+		return err
+	}
+	_, err = cl.CreateACLs(ctx, b)
+	return errors.Wrap(err, errCreateACL)
+}
+
+// Delete issues a DeleteACLs request for the given KafkaACL.
+func Delete(ctx context.Context, cl *kadm.Client, a *KafkaACL) error {
+	b, err := builder(a)
+	if err != nil {
+		return err
+	}
+	_, err = cl.DeleteACLs(ctx, b)
+	return errors.Wrap(err, errDeleteACL)
+}
+
+// List describes the given KafkaACL, returning it along with the operations
+// authorized for the requesting connection against its resource if it exists
+// on the broker, or nil if it does not.
+func List(ctx context.Context, cl *kadm.Client, a *KafkaACL) (*DescribedACL, error) {
+	b, err := builder(a)
+	if err != nil {
+		return nil, err
+	}
+	described, err := cl.DescribeACLs(ctx, b)
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeACL)
+	}
+
+	var found bool
+	for _, d := range described {
+		if d.Err != nil {
+			return nil, errors.Wrap(d.Err, errDescribeACL)
+		}
+		if len(d.Described) > 0 {
+			found = true
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	ops, err := authorizedOperations(ctx, cl, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DescribedACL{KafkaACL: *a, ProviderAuthorizedOperations: ops}, nil
+}
+
+// authorizedOperations asks the broker what operations the connection making
+// the request - this provider's own credentials - is actually authorized to
+// perform against a's resource, per KIP-430. The describe call used depends
+// on the resource type, since the broker only reports authorized operations
+// on the describe response for the matching resource kind.
+func authorizedOperations(ctx context.Context, cl *kadm.Client, a *KafkaACL) ([]string, error) {
+	switch a.ResourceType {
+	case "Cluster":
+		cluster, err := cl.DescribeCluster(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errDescribeCluster)
+		}
+		return OperationsFromBitmask(cluster.AuthorizedOperations), nil
+	case "Topic", "":
+		metadata, err := cl.Metadata(ctx, a.ResourceName)
+		if err != nil {
+			return nil, errors.Wrap(err, errDescribeTopic)
+		}
+		t, ok := metadata.Topics[a.ResourceName]
+		if !ok {
+			return nil, nil
+		}
+		return OperationsFromBitmask(t.AuthorizedOperations), nil
+	default:
+		// The broker doesn't expose authorized operations for Group,
+		// TransactionalID or DelegationToken resources through any describe
+		// call this provider currently wires up.
+		return nil, nil
+	}
+}
+
+// ClusterAuthorizedOperations returns the operations the broker reports as
+// authorized for the connection making the request against the cluster
+// resource itself.
+func ClusterAuthorizedOperations(ctx context.Context, cl *kadm.Client) ([]string, error) {
+	cluster, err := cl.DescribeCluster(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeCluster)
+	}
+	return OperationsFromBitmask(cluster.AuthorizedOperations), nil
+}
+
+// Update reconciles drift between the desired and observed KafkaACL. Because
+// ACLs are immutable, an update is performed by deleting the observed entry
+// and creating the desired one rather than mutating it in place.
+func Update(ctx context.Context, cl *kadm.Client, desired, observed *KafkaACL) error {
+	if CompareAcls(*desired, *observed) {
+		return nil
+	}
+
+	if err := Delete(ctx, cl, observed); err != nil {
+		return err
+	}
+
+	return Create(ctx, cl, desired)
+}
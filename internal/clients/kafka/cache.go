@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/v1alpha1"
+)
+
+// DefaultIdleTimeout is how long a ClientCache keeps a client around after
+// its last use before closing it.
+const DefaultIdleTimeout = 10 * time.Minute
+
+// cacheKey identifies one version of one ProviderConfig. Keying on
+// resourceVersion as well as UID means an edit to the ProviderConfig (e.g.
+// rotated credentials) naturally misses the cache rather than requiring a
+// watch to invalidate it.
+type cacheKey struct {
+	uid             types.UID
+	resourceVersion string
+}
+
+type cacheEntry struct {
+	mu        sync.Mutex
+	client    *kadm.Client
+	lastUsed  time.Time
+	refresher *TokenRefresher
+}
+
+func (e *cacheEntry) get() *kadm.Client {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lastUsed = time.Now()
+	return e.client
+}
+
+func (e *cacheEntry) close() {
+	if e.refresher != nil {
+		e.refresher.Stop()
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client != nil {
+		e.client.Close()
+	}
+}
+
+// closeIfIdle closes e and returns true if it's been idle for longer than
+// idleTimeout. The idle check and the close happen under the same lock
+// acquisition, so a Get that's concurrently refreshing lastUsed either wins
+// the race and stops this eviction, or loses it before closeIfIdle observes
+// any client handed out - there's no window in which a caller can be handed
+// a client that's then closed out from under it.
+func (e *cacheEntry) closeIfIdle(idleTimeout time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if time.Since(e.lastUsed) <= idleTimeout {
+		return false
+	}
+
+	if e.refresher != nil {
+		e.refresher.Stop()
+	}
+	if e.client != nil {
+		e.client.Close()
+	}
+	return true
+}
+
+// A ClientCache amortizes the cost of establishing a Kafka admin client
+// across the many managed resources that may share a single ProviderConfig,
+// rather than connecting and disconnecting on every reconcile.
+type ClientCache struct {
+	idleTimeout time.Duration
+	entries     sync.Map // cacheKey -> *cacheEntry
+}
+
+// NewClientCache returns a ClientCache that evicts clients idle for longer
+// than idleTimeout.
+func NewClientCache(idleTimeout time.Duration) *ClientCache {
+	c := &ClientCache{idleTimeout: idleTimeout}
+	go c.reapIdleEntries()
+	return c
+}
+
+// Get returns the cached client for pc, building and caching a new one if pc
+// hasn't been seen at its current resourceVersion before. Any entry cached
+// for a stale resourceVersion of the same ProviderConfig is closed and
+// evicted.
+func (c *ClientCache) Get(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (*kadm.Client, error) {
+	key := cacheKey{uid: pc.GetUID(), resourceVersion: pc.GetResourceVersion()}
+
+	if v, ok := c.entries.Load(key); ok {
+		return v.(*cacheEntry).get(), nil
+	}
+
+	c.evictStale(key)
+
+	e := &cacheEntry{lastUsed: time.Now()}
+	if err := c.populate(ctx, kube, pc, e); err != nil {
+		return nil, err
+	}
+
+	if actual, loaded := c.entries.LoadOrStore(key, e); loaded {
+		e.close()
+		return actual.(*cacheEntry).get(), nil
+	}
+
+	return e.get(), nil
+}
+
+func (c *ClientCache) populate(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig, e *cacheEntry) error {
+	if pc.Spec.AuthMechanism != v1alpha1.AuthMechanismOAuthBearer {
+		kc, err := NewAdminClient(ctx, pc, kube)
+		if err != nil {
+			return err
+		}
+		e.client = kc
+		return nil
+	}
+
+	e.refresher = &TokenRefresher{}
+	return e.refresher.Start(ctx, kube, pc, func(kc *kadm.Client, err error) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if err != nil {
+			return
+		}
+		if e.client != nil {
+			e.client.Close()
+		}
+		e.client = kc
+	})
+}
+
+// evictStale closes and removes any entry for the same ProviderConfig UID
+// cached under a different resourceVersion than key.
+func (c *ClientCache) evictStale(key cacheKey) {
+	c.entries.Range(func(k, v interface{}) bool {
+		ek := k.(cacheKey)
+		if ek.uid == key.uid && ek.resourceVersion != key.resourceVersion {
+			c.entries.Delete(k)
+			v.(*cacheEntry).close()
+		}
+		return true
+	})
+}
+
+func (c *ClientCache) reapIdleEntries() {
+	t := time.NewTicker(c.idleTimeout / 2)
+	defer t.Stop()
+
+	for range t.C {
+		c.entries.Range(func(k, v interface{}) bool {
+			e := v.(*cacheEntry)
+			if e.closeIfIdle(c.idleTimeout) {
+				c.entries.Delete(k)
+			}
+			return true
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cluster describes a Kafka cluster for the ClusterDescription
+// managed resource.
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kadm"
+
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka/acl"
+)
+
+const errDescribeCluster = "cannot describe cluster"
+
+// A Broker is the observed state of a single broker.
+type Broker struct {
+	NodeID int32
+	Host   string
+	Port   int32
+	Rack   *string
+}
+
+// A Described is the observed state of a cluster.
+type Described struct {
+	ClusterID            string
+	ControllerID         int32
+	Brokers              []Broker
+	AuthorizedOperations []string
+}
+
+// Describe fetches the cluster ID, controller, brokers and authorized
+// operations of the cluster the given client is connected to.
+func Describe(ctx context.Context, cl *kadm.Client) (*Described, error) {
+	c, err := cl.DescribeCluster(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeCluster)
+	}
+
+	d := &Described{
+		ClusterID:            c.ClusterID,
+		ControllerID:         c.Controller.NodeID,
+		AuthorizedOperations: acl.OperationsFromBitmask(c.AuthorizedOperations),
+	}
+	for _, n := range c.Brokers {
+		d.Brokers = append(d.Brokers, Broker{
+			NodeID: n.NodeID,
+			Host:   n.Host,
+			Port:   n.Port,
+			Rack:   n.Rack,
+		})
+	}
+
+	return d, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafka provides helpers for constructing Kafka admin clients from
+// the credentials configured on a ProviderConfig.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/v1alpha1"
+)
+
+const (
+	errParseCreds   = "cannot parse Kafka credentials"
+	errGetCreds     = "cannot get credentials"
+	errUnknownAuth  = "unknown auth mechanism"
+	errMissingField = "ProviderConfig is missing the fields required by its AuthMechanism"
+	errParseCert    = "cannot parse client certificate"
+	errParseCA      = "cannot parse CA bundle"
+)
+
+// Credentials is the shape of the credentials JSON blob referenced by a
+// ProviderConfig when AuthMechanism is Plain.
+type Credentials struct {
+	SASLUsername string `json:"saslUsername,omitempty"`
+	SASLPassword string `json:"saslPassword,omitempty"`
+}
+
+// credentialProvider builds the kgo.Opt slice that configures one
+// AuthMechanism's transport and authentication. Adding a mechanism means
+// adding an entry here rather than growing an if/else chain in
+// NewAdminClient.
+type credentialProvider func(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]kgo.Opt, error)
+
+var credentialProviders = map[v1alpha1.AuthMechanism]credentialProvider{
+	v1alpha1.AuthMechanismPlain:       plainOpts,
+	v1alpha1.AuthMechanismSCRAMSHA512: scramOpts,
+	v1alpha1.AuthMechanismOAuthBearer: oauthBearerOpts,
+	v1alpha1.AuthMechanismMTLS:        mtlsOpts,
+}
+
+// NewAdminClient builds a *kadm.Client authenticated per pc.Spec.AuthMechanism.
+func NewAdminClient(ctx context.Context, pc *v1alpha1.ProviderConfig, kube client.Client) (*kadm.Client, error) {
+	mechanism := pc.Spec.AuthMechanism
+	if mechanism == "" {
+		mechanism = v1alpha1.AuthMechanismPlain
+	}
+
+	provider, ok := credentialProviders[mechanism]
+	if !ok {
+		return nil, errors.Errorf("%s: %q", errUnknownAuth, mechanism)
+	}
+
+	opts, err := provider(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAdminClient(pc, opts)
+}
+
+// newAdminClientForOAuthBearerToken builds a *kadm.Client authenticated
+// with an already-fetched OAUTHBEARER token. It exists so that callers who
+// refresh the token on a schedule, such as TokenRefresher, can reuse the
+// token they just fetched instead of triggering a second round trip to the
+// token endpoint via oauthBearerOpts.
+func newAdminClientForOAuthBearerToken(pc *v1alpha1.ProviderConfig, token string) (*kadm.Client, error) {
+	return newAdminClient(pc, []kgo.Opt{oauthBearerOpt(token)})
+}
+
+func newAdminClient(pc *v1alpha1.ProviderConfig, opts []kgo.Opt) (*kadm.Client, error) {
+	opts = append(opts, kgo.SeedBrokers(pc.Spec.BrokerAddrs...))
+
+	kc, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return kadm.NewClient(kc), nil
+}
+
+func plainOpts(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]kgo.Opt, error) {
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	creds := &Credentials{}
+	if err := json.Unmarshal(data, creds); err != nil {
+		return nil, errors.Wrap(err, errParseCreds)
+	}
+	if creds.SASLUsername == "" {
+		return nil, nil
+	}
+
+	return []kgo.Opt{kgo.SASL(plain.Auth{
+		User: creds.SASLUsername,
+		Pass: creds.SASLPassword,
+	}.AsMechanism())}, nil
+}
+
+func scramOpts(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]kgo.Opt, error) {
+	s := pc.Spec.SCRAM
+	if s == nil {
+		return nil, errors.New(errMissingField)
+	}
+
+	user, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, s.Username)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	pass, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, s.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	return []kgo.Opt{kgo.SASL(scram.Auth{
+		User: string(user),
+		Pass: string(pass),
+	}.AsSha512Mechanism())}, nil
+}
+
+func mtlsOpts(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]kgo.Opt, error) {
+	m := pc.Spec.MTLS
+	if m == nil {
+		return nil, errors.New(errMissingField)
+	}
+
+	certPEM, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, m.Cert)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	keyPEM, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, m.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	caPEM, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, m.CA)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseCert)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New(errParseCA)
+	}
+
+	return []kgo.Opt{kgo.DialTLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+	})}, nil
+}
+
+func oauthBearerOpts(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]kgo.Opt, error) {
+	o := pc.Spec.OAuthBearer
+	if o == nil {
+		return nil, errors.New(errMissingField)
+	}
+
+	token, _, err := FetchOAuthBearerToken(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	return []kgo.Opt{oauthBearerOpt(token)}, nil
+}
+
+func oauthBearerOpt(token string) kgo.Opt {
+	return kgo.SASL(oauth.Auth{Token: token}.AsMechanism())
+}
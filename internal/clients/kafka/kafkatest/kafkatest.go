@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafkatest provides an in-process fake Kafka broker for exercising
+// code that talks to a cluster via kadm, without requiring a real one.
+package kafkatest
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// NewClient starts an in-process fake Kafka broker supporting ApiVersions,
+// SaslHandshake, CreateAcls, DescribeAcls, DeleteAcls and Metadata, and
+// returns a kadm.Client connected to it. The broker and its client are
+// closed via t.Cleanup.
+func NewClient(t *testing.T, opts ...kfake.Opt) (*kadm.Client, *kfake.Cluster) {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(opts...)
+	if err != nil {
+		t.Fatalf("kfake.NewCluster: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+
+	cl, err := kgo.NewClient(kgo.SeedBrokers(cluster.ListenAddrs()...))
+	if err != nil {
+		t.Fatalf("kgo.NewClient: %v", err)
+	}
+	t.Cleanup(cl.Close)
+
+	return kadm.NewClient(cl), cluster
+}
+
+// FailNext makes cluster respond to the next request with the given API key
+// with err, so tests can exercise error-handling paths that a well-behaved
+// broker would never trigger.
+func FailNext(cluster *kfake.Cluster, key kmsg.Key, err error) {
+	var fired bool
+	cluster.Control(func(req kmsg.Request) (kmsg.Response, error, bool) {
+		if fired || kmsg.Key(req.Key()) != key {
+			return nil, nil, false
+		}
+		fired = true
+		return nil, err, true
+	})
+}
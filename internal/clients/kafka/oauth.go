@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/v1alpha1"
+)
+
+const errFetchToken = "cannot fetch OAUTHBEARER token"
+
+// tokenResponse is the subset of an OAuth2 client-credentials response this
+// provider cares about.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// FetchOAuthBearerToken runs the OAuth2 client-credentials flow described by
+// pc.Spec.OAuthBearer and returns the resulting access token and its
+// lifetime.
+func FetchOAuthBearerToken(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (string, time.Duration, error) {
+	o := pc.Spec.OAuthBearer
+
+	id, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, o.ClientID)
+	if err != nil {
+		return "", 0, errors.Wrap(err, errGetCreds)
+	}
+	secret, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, kube, o.ClientSecret)
+	if err != nil {
+		return "", 0, errors.Wrap(err, errGetCreds)
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, errors.Wrap(err, errFetchToken)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(string(id), string(secret))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, errors.Wrap(err, errFetchToken)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, errors.Errorf("%s: unexpected status %d", errFetchToken, resp.StatusCode)
+	}
+
+	t := &tokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(t); err != nil {
+		return "", 0, errors.Wrap(err, errFetchToken)
+	}
+
+	return t.AccessToken, time.Duration(t.ExpiresIn) * time.Second, nil
+}
+
+// TokenRefresher periodically refetches an OAUTHBEARER token before it
+// expires and invokes onRotate with a freshly built admin client whenever
+// the token rotates, so callers can amortize the cost of establishing a new
+// connection across reconciles instead of paying it on every poll.
+type TokenRefresher struct {
+	stop chan struct{}
+}
+
+// Start begins refreshing pc's OAUTHBEARER token in the background. The
+// first fetch happens synchronously so the caller has a usable client
+// before Start returns. Stop must be called to release the goroutine.
+func (r *TokenRefresher) Start(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig, onRotate func(*kadm.Client, error)) error {
+	r.stop = make(chan struct{})
+
+	rebuild := func(ctx context.Context) (time.Duration, error) {
+		token, ttl, err := FetchOAuthBearerToken(ctx, kube, pc)
+		if err != nil {
+			return 0, err
+		}
+		kc, err := newAdminClientForOAuthBearerToken(pc, token)
+		if err != nil {
+			return 0, err
+		}
+		onRotate(kc, nil)
+		return ttl, nil
+	}
+
+	ttl, err := rebuild(ctx)
+	if err != nil {
+		return err
+	}
+
+	// ctx only bounds the first fetch; the background refreshes outlive the
+	// caller and run until Stop.
+	go func() {
+		for {
+			select {
+			case <-time.After(refreshBefore(ttl)):
+				ttl, err = rebuild(context.Background())
+				if err != nil {
+					onRotate(nil, err)
+					ttl = time.Minute
+				}
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop halts the background refresh goroutine started by Start.
+func (r *TokenRefresher) Stop() {
+	if r.stop != nil {
+		close(r.stop)
+	}
+}
+
+// refreshBefore returns how long to wait before refreshing a token with the
+// given lifetime, refreshing at 80% of its lifetime so a slow refresh can't
+// let the token lapse.
+func refreshBefore(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return time.Minute
+	}
+	return ttl * 4 / 5
+}
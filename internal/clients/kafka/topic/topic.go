@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topic describes Kafka topics for the TopicDescription managed
+// resource.
+package topic
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kadm"
+
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka/acl"
+)
+
+const (
+	errMetadata        = "cannot fetch topic metadata"
+	errDescribeConfigs = "cannot describe topic configs"
+)
+
+// A Partition describes the observed state of a single topic partition.
+type Partition struct {
+	ID       int32
+	Leader   int32
+	Replicas []int32
+	ISR      []int32
+}
+
+// A Described is the observed state of a topic.
+type Described struct {
+	Partitions           []Partition
+	Configs              map[string]string
+	AuthorizedOperations []string
+}
+
+// Describe fetches the partition layout, configuration and authorized
+// operations of the named topic.
+func Describe(ctx context.Context, cl *kadm.Client, topicName string) (*Described, error) {
+	metadata, err := cl.Metadata(ctx, topicName)
+	if err != nil {
+		return nil, errors.Wrap(err, errMetadata)
+	}
+
+	d := &Described{Configs: map[string]string{}}
+
+	t, ok := metadata.Topics[topicName]
+	if !ok {
+		return nil, nil
+	}
+
+	for _, p := range t.Partitions.Sorted() {
+		d.Partitions = append(d.Partitions, Partition{
+			ID:       p.Partition,
+			Leader:   p.Leader,
+			Replicas: p.Replicas,
+			ISR:      p.ISR,
+		})
+	}
+	d.AuthorizedOperations = acl.OperationsFromBitmask(t.AuthorizedOperations)
+
+	configs, err := cl.DescribeTopicConfigs(ctx, topicName)
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeConfigs)
+	}
+	for _, rc := range configs {
+		for _, c := range rc.Configs {
+			d.Configs[c.Key] = c.MaybeValue()
+		}
+	}
+
+	return d, nil
+}
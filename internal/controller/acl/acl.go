@@ -18,7 +18,6 @@ package acl
 
 import (
 	"context"
-	"strings"
 
 	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka"
 
@@ -49,10 +48,11 @@ const (
 	errNotAccessControlList = "managed resource is not a AccessControlList custom resource"
 	errTrackPCUsage         = "cannot track ProviderConfig usage"
 	errGetPC                = "cannot get ProviderConfig"
-	errGetCreds             = "cannot get credentials"
 	errListACL              = "cannot List ACLs"
 	errNewClient            = "cannot create new Service"
-	errUpdateNotSupported   = "updates are not supported"
+	errUpdateACL            = "cannot update ACL"
+	errConvertExternalName  = "cannot convert external name"
+	errDescribeCluster      = "cannot describe cluster authorized operations"
 )
 
 // Setup adds a controller that reconciles AccessControlList managed resources.
@@ -66,10 +66,10 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.AccessControlListGroupVersionKind),
 		managed.WithExternalConnectDisconnecter(&connectDisconnector{
-			kube:         mgr.GetClient(),
-			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-			log:          l,
-			newServiceFn: kafka.NewAdminClient}),
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			log:   l,
+			cache: kafka.NewClientCache(kafka.DefaultIdleTimeout)}),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithInitializers())
@@ -84,18 +84,17 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 // A connectDisconnector is expected to produce an ExternalClient when its Connect method
 // is called and close it when its Disconnect method is called.
 type connectDisconnector struct {
-	kube         client.Client
-	usage        resource.Tracker
-	log          logging.Logger
-	newServiceFn func(ctx context.Context, creds []byte, kube client.Client) (*kadm.Client, error)
-	cachedClient *kadm.Client
+	kube  client.Client
+	usage resource.Tracker
+	log   logging.Logger
+	cache *kafka.ClientCache
 }
 
 // Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+//  1. Tracking that the managed resource is using a ProviderConfig.
+//  2. Getting the managed resource's ProviderConfig.
+//  3. Using the ProviderConfig's credentials to form a client, reusing a
+//     cached one if its ProviderConfig hasn't changed since.
 func (c *connectDisconnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
 	cr, ok := mg.(*v1alpha1.AccessControlList)
 	if !ok {
@@ -111,26 +110,17 @@ func (c *connectDisconnector) Connect(ctx context.Context, mg resource.Managed)
 		return nil, errors.Wrap(err, errGetPC)
 	}
 
-	cd := pc.Spec.Credentials
-	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
-	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
-	}
-
-	svc, err := c.newServiceFn(ctx, data, c.kube)
+	svc, err := c.cache.Get(ctx, c.kube, pc)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	c.cachedClient = svc
 
 	return &external{kafkaClient: svc, log: c.log}, nil
 }
 
+// Disconnect is a no-op: the client backing this external connection is
+// owned by c.cache, which outlives any single reconcile.
 func (c *connectDisconnector) Disconnect(ctx context.Context) error {
-	if c.cachedClient != nil {
-		c.cachedClient.Close()
-	}
-	c.cachedClient = nil
 	return nil
 }
 
@@ -139,6 +129,13 @@ func (c *connectDisconnector) Disconnect(ctx context.Context) error {
 type external struct {
 	kafkaClient *kadm.Client
 	log         logging.Logger
+
+	// clusterOps caches the cluster-level authorized operations for the
+	// lifetime of this external client, which is scoped to a single
+	// reconcile, so ClusterAuthorizedOperations is called at most once per
+	// poll. For a Cluster ACL, acl.List issues a DescribeCluster of its own
+	// as well.
+	clusterOps []string
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -153,16 +150,17 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	extname, _ := acl.ConvertFromJSON(meta.GetExternalName(cr))
+	extname, err := acl.ConvertFromJSON(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errConvertExternalName)
+	}
 	compare := acl.CompareAcls(*extname, *acl.Generate(&cr.Spec.ForProvider))
-	diff := acl.Diff(*extname, *acl.Generate(&cr.Spec.ForProvider))
 
 	if !compare {
-		err := strings.Join(diff, " ")
 		return managed.ExternalObservation{
 			ResourceExists:   true,
 			ResourceUpToDate: false,
-		}, errors.New(err)
+		}, nil
 	}
 
 	ae, err := acl.List(ctx, c.kafkaClient, extname)
@@ -175,6 +173,15 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
+	if c.clusterOps == nil {
+		c.clusterOps, err = acl.ClusterAuthorizedOperations(ctx, c.kafkaClient)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribeCluster)
+		}
+	}
+
+	cr.Status.AtProvider.ProviderAuthorizedOperations = ae.ProviderAuthorizedOperations
+	cr.Status.AtProvider.ClusterAuthorizedOperations = c.clusterOps
 	cr.Status.SetConditions(v1.Available())
 
 	return managed.ExternalObservation{
@@ -206,7 +213,28 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 
-	return managed.ExternalUpdate{}, errors.New(errUpdateNotSupported)
+	cr, ok := mg.(*v1alpha1.AccessControlList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessControlList)
+	}
+
+	observed, err := acl.ConvertFromJSON(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errConvertExternalName)
+	}
+
+	desired := acl.Generate(&cr.Spec.ForProvider)
+	if err := acl.Update(ctx, c.kafkaClient, desired, observed); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateACL)
+	}
+
+	extname, err := acl.ConvertToJSON(desired)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errConvertExternalName)
+	}
+	meta.SetExternalName(cr, extname)
+
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
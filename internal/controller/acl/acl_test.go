@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/acl/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-kafka/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka"
+	aclclient "github.com/crossplane-contrib/provider-kafka/internal/clients/kafka/acl"
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka/kafkatest"
+)
+
+func testACL() *v1alpha1.AccessControlList {
+	cr := &v1alpha1.AccessControlList{}
+	cr.Spec.ForProvider = v1alpha1.AccessControlListParameters{
+		ResourceType:        "Topic",
+		ResourceName:        "widgets",
+		ResourcePatternType: "Literal",
+		Principal:           "User:alice",
+		Host:                "*",
+		Operation:           "Read",
+		PermissionType:      "Allow",
+	}
+	return cr
+}
+
+func TestCreateObserveDelete(t *testing.T) {
+	cl, _ := kafkatest.NewClient(t)
+	e := &external{kafkaClient: cl, log: logging.NewNopLogger()}
+	ctx := context.Background()
+
+	cr := testACL()
+
+	if meta.GetExternalName(cr) != "" {
+		t.Fatalf("expected no external name before create")
+	}
+
+	creation, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !creation.ExternalNameAssigned {
+		t.Fatalf("expected Create to assign an external name")
+	}
+	if meta.GetExternalName(cr) == "" {
+		t.Fatalf("expected external name to be set after create")
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if !obs.ResourceExists || !obs.ResourceUpToDate {
+		t.Fatalf("expected ACL to exist and be up to date, got %+v", obs)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("Observe after delete: %v", err)
+	}
+	if obs.ResourceExists {
+		t.Fatalf("expected ACL to no longer exist after delete, got %+v", obs)
+	}
+}
+
+func TestObserveDetectsDrift(t *testing.T) {
+	cl, _ := kafkatest.NewClient(t)
+	e := &external{kafkaClient: cl, log: logging.NewNopLogger()}
+	ctx := context.Background()
+
+	cr := testACL()
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Drift the desired spec away from what's encoded in the external name,
+	// without touching the broker.
+	cr.Spec.ForProvider.Operation = "Write"
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		t.Fatalf("expected drift to be reported as ResourceExists=true, ResourceUpToDate=false, got %+v", obs)
+	}
+}
+
+func TestUpdateDeletesAndRecreates(t *testing.T) {
+	cl, _ := kafkatest.NewClient(t)
+	e := &external{kafkaClient: cl, log: logging.NewNopLogger()}
+	ctx := context.Background()
+
+	cr := testACL()
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	observed, err := aclclient.ConvertFromJSON(meta.GetExternalName(cr))
+	if err != nil {
+		t.Fatalf("ConvertFromJSON: %v", err)
+	}
+
+	cr.Spec.ForProvider.Operation = "Write"
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	// The old ACL should be gone...
+	if d, err := aclclient.List(ctx, cl, observed); err != nil {
+		t.Fatalf("List old ACL: %v", err)
+	} else if d != nil {
+		t.Fatalf("expected old ACL to have been deleted, got %+v", d)
+	}
+
+	// ...and the new one should exist in its place.
+	desired := aclclient.Generate(&cr.Spec.ForProvider)
+	if d, err := aclclient.List(ctx, cl, desired); err != nil {
+		t.Fatalf("List new ACL: %v", err)
+	} else if d == nil {
+		t.Fatalf("expected new ACL to have been created")
+	}
+}
+
+func TestObserveWrapsDescribeACLsError(t *testing.T) {
+	cl, cluster := kafkatest.NewClient(t)
+	e := &external{kafkaClient: cl, log: logging.NewNopLogger()}
+	ctx := context.Background()
+
+	cr := testACL()
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	kafkatest.FailNext(cluster, kmsg.DescribeACLs, errors.New("broker unavailable"))
+
+	if _, err := e.Observe(ctx, cr); err == nil || !strings.Contains(err.Error(), errListACL) {
+		t.Fatalf("expected Observe to wrap the broker error as errListACL, got %v", err)
+	}
+}
+
+func TestConnectWrapsUnknownAuthMechanism(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := apisv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pc"},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			BrokerAddrs:   []string{"127.0.0.1:9092"},
+			AuthMechanism: "Bogus",
+		},
+	}
+
+	c := &connectDisconnector{
+		kube:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(pc).Build(),
+		usage: noopTracker{},
+		log:   logging.NewNopLogger(),
+		cache: kafka.NewClientCache(kafka.DefaultIdleTimeout),
+	}
+
+	cr := testACL()
+	cr.Spec.ProviderConfigReference = &xpv1.Reference{Name: "pc"}
+
+	if _, err := c.Connect(context.Background(), cr); err == nil || !strings.Contains(err.Error(), errNewClient) {
+		t.Fatalf("expected Connect to wrap the unknown auth mechanism as errNewClient, got %v", err)
+	}
+}
+
+type noopTracker struct{}
+
+func (noopTracker) Track(ctx context.Context, mg resource.Managed) error { return nil }
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topicdescription
+
+import (
+	"context"
+
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/twmb/franz-go/pkg/kadm"
+
+	"github.com/crossplane-contrib/provider-kafka/internal/clients/kafka/topic"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-kafka/apis/topicdescription/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-kafka/apis/v1alpha1"
+)
+
+const (
+	errNotTopicDescription = "managed resource is not a TopicDescription custom resource"
+	errTrackPCUsage        = "cannot track ProviderConfig usage"
+	errGetPC               = "cannot get ProviderConfig"
+	errNewClient           = "cannot create new Service"
+	errDescribeTopic       = "cannot describe topic"
+)
+
+// Setup adds a controller that reconciles TopicDescription managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TopicDescriptionGroupKind)
+
+	o := controller.Options{
+		RateLimiter: ratelimiter.NewController(),
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TopicDescriptionGroupVersionKind),
+		managed.WithExternalConnectDisconnecter(&connectDisconnector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			log:   l,
+			cache: kafka.NewClientCache(kafka.DefaultIdleTimeout)}),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithInitializers())
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.TopicDescription{}).
+		Complete(r)
+}
+
+// A connectDisconnector produces an ExternalClient when its Connect method is
+// called. Its Disconnect method closes nothing: clients live in its cache.
+type connectDisconnector struct {
+	kube  client.Client
+	usage resource.Tracker
+	log   logging.Logger
+	cache *kafka.ClientCache
+}
+
+// Connect typically produces an ExternalClient by:
+//  1. Tracking that the managed resource is using a ProviderConfig.
+//  2. Getting the managed resource's ProviderConfig.
+//  3. Using the ProviderConfig's credentials to form a client, reusing a
+//     cached one if its ProviderConfig hasn't changed since.
+func (c *connectDisconnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TopicDescription)
+	if !ok {
+		return nil, errors.New(errNotTopicDescription)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := c.cache.Get(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{kafkaClient: svc, log: c.log}, nil
+}
+
+// Disconnect is a no-op: the client backing this external connection is
+// owned by c.cache, which outlives any single reconcile.
+func (c *connectDisconnector) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+// An ExternalClient observes a TopicDescription. TopicDescription is
+// observe-only: Create, Update and Delete only ever manage the managed
+// resource's external name, never the topic itself.
+type external struct {
+	kafkaClient *kadm.Client
+	log         logging.Logger
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TopicDescription)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTopicDescription)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d, err := topic.Describe(ctx, c.kafkaClient, cr.Spec.ForProvider.TopicName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeTopic)
+	}
+	if d == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.Configs = d.Configs
+	cr.Status.AtProvider.AuthorizedOperations = d.AuthorizedOperations
+	cr.Status.AtProvider.Partitions = nil
+	for _, p := range d.Partitions {
+		cr.Status.AtProvider.Partitions = append(cr.Status.AtProvider.Partitions, v1alpha1.TopicPartition{
+			ID:       p.ID,
+			Leader:   p.Leader,
+			Replicas: p.Replicas,
+			ISR:      p.ISR,
+		})
+	}
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create sets the external name of a TopicDescription. It never creates a
+// topic: TopicDescription only observes topics that already exist.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TopicDescription)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTopicDescription)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.TopicName)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update is a no-op: TopicDescription never mutates the topic it observes.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op: TopicDescription never mutates the topic it observes.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}